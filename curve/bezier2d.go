@@ -0,0 +1,89 @@
+package curve
+
+import (
+	"github.com/iuga/gomath/vector"
+	"golang.org/x/exp/constraints"
+)
+
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Bezier2D represents a quadratic or cubic (or higher order) Bezier curve defined by an ordered list
+// of control points.
+type Bezier2D[T Number] struct {
+	// Points are the curve's control points, in order. The curve passes through the first and last
+	// point, and is pulled toward the points in between.
+	Points []*vector.Vector2D[T] `json:"points"`
+}
+
+// NewBezier2D constructs a new Bezier2D from the given ordered control points.
+func NewBezier2D[T Number](points ...*vector.Vector2D[T]) *Bezier2D[T] {
+	return &Bezier2D[T]{Points: points}
+}
+
+// Evaluate returns the point on the curve at parameter t in [0,1], computed via de Casteljau's
+// algorithm: repeatedly LERP adjacent control points until a single point remains.
+func (b *Bezier2D[T]) Evaluate(t float64) *vector.Vector2D[T] {
+	points := b.Points
+	for len(points) > 1 {
+		points = lerpAdjacent(points, t)
+	}
+	return points[0]
+}
+
+// Derivative returns the tangent vector of the curve at parameter t. For a degree-n Bezier curve, this
+// is n times the difference between the two points of the degree-(n-1) curve de Casteljau's algorithm
+// produces at t.
+func (b *Bezier2D[T]) Derivative(t float64) *vector.Vector2D[T] {
+	n := len(b.Points) - 1
+	points := b.Points
+	for len(points) > 2 {
+		points = lerpAdjacent(points, t)
+	}
+	return points[1].Subtract(points[0]).Scale(T(n))
+}
+
+// Split divides this curve at parameter t into two Bezier curves, left and right, which together
+// retrace the original curve over [0,t] and [t,1]. This is the standard approach to adaptive
+// subdivision: the intermediate points of de Casteljau's algorithm at t are exactly the new control
+// points of the two halves.
+func (b *Bezier2D[T]) Split(t float64) (left, right *Bezier2D[T]) {
+	points := b.Points
+	leftPoints := make([]*vector.Vector2D[T], 0, len(points))
+	rightPoints := make([]*vector.Vector2D[T], 0, len(points))
+	leftPoints = append(leftPoints, points[0])
+	rightPoints = append(rightPoints, points[len(points)-1])
+	for len(points) > 1 {
+		points = lerpAdjacent(points, t)
+		leftPoints = append(leftPoints, points[0])
+		rightPoints = append(rightPoints, points[len(points)-1])
+	}
+	for i, j := 0, len(rightPoints)-1; i < j; i, j = i+1, j-1 {
+		rightPoints[i], rightPoints[j] = rightPoints[j], rightPoints[i]
+	}
+	return &Bezier2D[T]{Points: leftPoints}, &Bezier2D[T]{Points: rightPoints}
+}
+
+// Length approximates the arc length of the curve by sampling it at steps+1 evenly spaced parameters
+// and summing the distances between consecutive samples.
+func (b *Bezier2D[T]) Length(steps int) T {
+	var total T
+	prev := b.Evaluate(0)
+	for i := 1; i <= steps; i++ {
+		next := b.Evaluate(float64(i) / float64(steps))
+		total += prev.DistanceTo(next)
+		prev = next
+	}
+	return total
+}
+
+// lerpAdjacent returns a new slice one shorter than points, where each element is the LERP of two
+// adjacent points at weight t. This is the single reduction step of de Casteljau's algorithm.
+func lerpAdjacent[T Number](points []*vector.Vector2D[T], t float64) []*vector.Vector2D[T] {
+	next := make([]*vector.Vector2D[T], len(points)-1)
+	for i := 0; i < len(points)-1; i++ {
+		next[i] = points[i].LERP(points[i+1], t)
+	}
+	return next
+}
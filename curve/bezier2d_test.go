@@ -0,0 +1,39 @@
+package curve_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/curve"
+	"github.com/iuga/gomath/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBezierEvaluate(t *testing.T) {
+	b := curve.NewBezier2D[float64](
+		vector.New2D[float64](0, 0),
+		vector.New2D[float64](5, 10),
+		vector.New2D[float64](10, 0),
+	)
+	require.True(t, b.Evaluate(0).ApproxEquals(vector.New2D[float64](0, 0), vector.EPSILON))
+	require.True(t, b.Evaluate(1).ApproxEquals(vector.New2D[float64](10, 0), vector.EPSILON))
+	require.True(t, b.Evaluate(0.5).ApproxEquals(vector.New2D[float64](5, 5), vector.EPSILON))
+}
+
+func TestBezierSplit(t *testing.T) {
+	b := curve.NewBezier2D[float64](
+		vector.New2D[float64](0, 0),
+		vector.New2D[float64](5, 10),
+		vector.New2D[float64](10, 0),
+	)
+	left, right := b.Split(0.5)
+	require.True(t, left.Evaluate(1).ApproxEquals(b.Evaluate(0.5), vector.EPSILON))
+	require.True(t, right.Evaluate(0).ApproxEquals(b.Evaluate(0.5), vector.EPSILON))
+}
+
+func TestBezierLength(t *testing.T) {
+	b := curve.NewBezier2D[float64](
+		vector.New2D[float64](0, 0),
+		vector.New2D[float64](10, 0),
+	)
+	require.InDelta(t, 10.0, b.Length(10), 1e-6)
+}
@@ -0,0 +1,85 @@
+package curve
+
+import (
+	"github.com/iuga/gomath/vector"
+)
+
+// BSpline2D represents a uniform B-spline curve of the given Degree (3 for the common cubic case)
+// defined over an ordered list of control points.
+type BSpline2D[T Number] struct {
+	// ControlPoints are the curve's control points, in order.
+	ControlPoints []*vector.Vector2D[T] `json:"control_points"`
+	// Degree is the polynomial degree of the curve (3 for a cubic B-spline).
+	Degree int `json:"degree"`
+}
+
+// NewBSpline2D constructs a new uniform BSpline2D of the given degree from the ordered control points.
+func NewBSpline2D[T Number](degree int, points ...*vector.Vector2D[T]) *BSpline2D[T] {
+	return &BSpline2D[T]{ControlPoints: points, Degree: degree}
+}
+
+// knots returns this spline's clamped uniform knot vector, which repeats the first and last knot
+// Degree+1 times so the curve interpolates its first and last control point.
+func (s *BSpline2D[T]) knots() []float64 {
+	n := len(s.ControlPoints) - 1
+	p := s.Degree
+	m := n + p + 1
+
+	knots := make([]float64, m+1)
+	for i := 0; i <= p; i++ {
+		knots[i] = 0
+	}
+	for i := m - p; i <= m; i++ {
+		knots[i] = 1
+	}
+	span := m - 2*p
+	for i := p + 1; i < m-p; i++ {
+		knots[i] = float64(i-p) / float64(span)
+	}
+	return knots
+}
+
+// Evaluate returns the point on the curve at parameter t in [0,1], via the standard basis-function
+// evaluation: the weighted sum of every control point by its Cox-de Boor basis function at t.
+func (s *BSpline2D[T]) Evaluate(t float64) *vector.Vector2D[T] {
+	knots := s.knots()
+	var x, y float64
+	for i, p := range s.ControlPoints {
+		w := bsplineBasis(i, s.Degree, t, knots)
+		x += w * float64(p.X)
+		y += w * float64(p.Y)
+	}
+	return vector.New2D[T](T(x), T(y))
+}
+
+// Sample returns n+1 evenly spaced points along the curve, suitable as a polyline for rasterization.
+func (s *BSpline2D[T]) Sample(n int) []*vector.Vector2D[T] {
+	points := make([]*vector.Vector2D[T], 0, n+1)
+	for i := 0; i <= n; i++ {
+		points = append(points, s.Evaluate(float64(i)/float64(n)))
+	}
+	return points
+}
+
+// bsplineBasis evaluates the Cox-de Boor recurrence for basis function i of degree p at parameter t
+// over the given knot vector.
+func bsplineBasis(i, p int, t float64, knots []float64) float64 {
+	if p == 0 {
+		if knots[i] <= t && t < knots[i+1] {
+			return 1
+		}
+		if t == 1 && knots[i+1] == 1 && knots[i] < knots[i+1] {
+			return 1
+		}
+		return 0
+	}
+
+	var left, right float64
+	if d := knots[i+p] - knots[i]; d != 0 {
+		left = (t - knots[i]) / d * bsplineBasis(i, p-1, t, knots)
+	}
+	if d := knots[i+p+1] - knots[i+1]; d != 0 {
+		right = (knots[i+p+1] - t) / d * bsplineBasis(i+1, p-1, t, knots)
+	}
+	return left + right
+}
@@ -0,0 +1,23 @@
+package curve_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/curve"
+	"github.com/iuga/gomath/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBSplineSample(t *testing.T) {
+	s := curve.NewBSpline2D[float64](3,
+		vector.New2D[float64](0, 0),
+		vector.New2D[float64](1, 2),
+		vector.New2D[float64](2, 2),
+		vector.New2D[float64](3, 0),
+		vector.New2D[float64](4, 0),
+	)
+	points := s.Sample(8)
+	require.Len(t, points, 9)
+	require.True(t, points[0].ApproxEquals(vector.New2D[float64](0, 0), vector.EPSILON))
+	require.True(t, points[len(points)-1].ApproxEquals(vector.New2D[float64](4, 0), vector.EPSILON))
+}
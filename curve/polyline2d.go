@@ -0,0 +1,94 @@
+package curve
+
+import (
+	"github.com/iuga/gomath/vector"
+)
+
+// Polyline2D represents an ordered chain of straight segments through a list of points. It lets
+// game/UI code interpolate motion along an arbitrary curve once that curve has been flattened via
+// Bezier2D.Evaluate or BSpline2D.Sample.
+type Polyline2D[T Number] struct {
+	// Points are the polyline's vertices, in order.
+	Points []*vector.Vector2D[T] `json:"points"`
+}
+
+// NewPolyline2D constructs a new Polyline2D from the given ordered points.
+func NewPolyline2D[T Number](points ...*vector.Vector2D[T]) *Polyline2D[T] {
+	return &Polyline2D[T]{Points: points}
+}
+
+// Length returns the total length of the polyline, the sum of its segment lengths.
+func (p *Polyline2D[T]) Length() T {
+	var total T
+	for i := 1; i < len(p.Points); i++ {
+		total += p.Points[i-1].DistanceTo(p.Points[i])
+	}
+	return total
+}
+
+// ClosestPoint returns the point on the polyline closest to target, by projecting target onto every
+// segment and clamping the projection to the segment's extent.
+func (p *Polyline2D[T]) ClosestPoint(target *vector.Vector2D[T]) *vector.Vector2D[T] {
+	if len(p.Points) == 1 {
+		return p.Points[0].Clone()
+	}
+	var best *vector.Vector2D[T]
+	var bestDist T
+	for i := 1; i < len(p.Points); i++ {
+		candidate := closestOnSegment(p.Points[i-1], p.Points[i], target)
+		d := candidate.DistanceSquaredTo(target)
+		if best == nil || d < bestDist {
+			best, bestDist = candidate, d
+		}
+	}
+	return best
+}
+
+// closestOnSegment returns the point on segment a-b closest to target.
+func closestOnSegment[T Number](a, b, target *vector.Vector2D[T]) *vector.Vector2D[T] {
+	ab := b.Subtract(a)
+	l2 := ab.LengthSquared()
+	if l2 == 0 {
+		return a.Clone()
+	}
+	weight := float64(target.Subtract(a).Dot(ab)) / float64(l2)
+	if weight < 0 {
+		weight = 0
+	}
+	if weight > 1 {
+		weight = 1
+	}
+	return a.LERP(b, weight)
+}
+
+// Sample returns n+1 points evenly spaced by arc length along the polyline, suitable for stepping
+// motion along the curve with MoveToward-style interpolation.
+func (p *Polyline2D[T]) Sample(n int) []*vector.Vector2D[T] {
+	total := p.Length()
+	if n == 0 || total == 0 {
+		return []*vector.Vector2D[T]{p.Points[0].Clone()}
+	}
+	samples := make([]*vector.Vector2D[T], 0, n+1)
+	for i := 0; i <= n; i++ {
+		samples = append(samples, p.pointAtDistance(T(float64(total)*float64(i)/float64(n))))
+	}
+	return samples
+}
+
+// pointAtDistance walks the polyline's segments and returns the point at arc length d from the start.
+func (p *Polyline2D[T]) pointAtDistance(d T) *vector.Vector2D[T] {
+	var traveled T
+	for i := 1; i < len(p.Points); i++ {
+		a, b := p.Points[i-1], p.Points[i]
+		segment := a.DistanceTo(b)
+		if traveled+segment >= d {
+			var weight float64
+			if segment != 0 {
+				weight = float64(d-traveled) / float64(segment)
+			}
+			return a.LERP(b, weight)
+		}
+		traveled += segment
+	}
+	return p.Points[len(p.Points)-1].Clone()
+}
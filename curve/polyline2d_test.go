@@ -0,0 +1,33 @@
+package curve_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/curve"
+	"github.com/iuga/gomath/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolylineLengthAndSample(t *testing.T) {
+	p := curve.NewPolyline2D[float64](
+		vector.New2D[float64](0, 0),
+		vector.New2D[float64](10, 0),
+		vector.New2D[float64](10, 10),
+	)
+	require.InDelta(t, 20.0, p.Length(), vector.EPSILON)
+
+	samples := p.Sample(2)
+	require.Len(t, samples, 3)
+	require.True(t, samples[0].ApproxEquals(vector.New2D[float64](0, 0), vector.EPSILON))
+	require.True(t, samples[1].ApproxEquals(vector.New2D[float64](10, 0), vector.EPSILON))
+	require.True(t, samples[2].ApproxEquals(vector.New2D[float64](10, 10), vector.EPSILON))
+}
+
+func TestPolylineClosestPoint(t *testing.T) {
+	p := curve.NewPolyline2D[float64](
+		vector.New2D[float64](0, 0),
+		vector.New2D[float64](10, 0),
+	)
+	closest := p.ClosestPoint(vector.New2D[float64](5, 3))
+	require.True(t, closest.ApproxEquals(vector.New2D[float64](5, 0), vector.EPSILON))
+}
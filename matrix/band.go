@@ -0,0 +1,137 @@
+package matrix
+
+import "fmt"
+
+// Band is a square matrix that only stores the elements within k diagonals of the main diagonal,
+// in O(n*k) instead of a dense O(n^2) allocation. Positions outside the bandwidth always read as zero.
+type Band[T Number] struct {
+	n         int
+	k         int
+	diagonals [][]T // diagonals[offset+k] holds the diagonal offset positions away from the main one
+}
+
+// NewBand constructs a new, zeroed n x n Band matrix with bandwidth k (k diagonals on either side of
+// the main diagonal). k=1 gives the common tridiagonal case.
+func NewBand[T Number](n, k int) *Band[T] {
+	diagonals := make([][]T, 2*k+1)
+	for i := range diagonals {
+		diagonals[i] = make([]T, n)
+	}
+	return &Band[T]{n: n, k: k, diagonals: diagonals}
+}
+
+// Set assigns value at position (row, column). It returns an error if position falls outside the
+// bandwidth and value is non-zero.
+func (b *Band[T]) Set(position *Position, value T) error {
+	if b.isPositionOutOfBounds(position) {
+		return fmt.Errorf("out of bound position %v with shape %v", position, b.GetShape())
+	}
+	offset := position.Column - position.Row
+	if offset < -b.k || offset > b.k {
+		if value != 0 {
+			return fmt.Errorf("Set(...) position %v is outside the bandwidth (%d) of this Band matrix", position, b.k)
+		}
+		return nil
+	}
+	b.diagonals[offset+b.k][position.Row] = value
+	return nil
+}
+
+// At returns the value at position (row, column). Positions outside the bandwidth always read as zero.
+func (b *Band[T]) At(position *Position) (T, error) {
+	if b.isPositionOutOfBounds(position) {
+		return 0, fmt.Errorf("out of bound position %v with shape %v", position, b.GetShape())
+	}
+	offset := position.Column - position.Row
+	if offset < -b.k || offset > b.k {
+		return 0, nil
+	}
+	return b.diagonals[offset+b.k][position.Row], nil
+}
+
+// GetShape returns a vector representing the dimensionality of the Band as (rows, columns).
+func (b *Band[T]) GetShape() *Shape {
+	return NewShape(b.n, b.n)
+}
+
+// MatMul multiplies this band matrix by dense matrix m, only visiting the non-zero band entries.
+func (b *Band[T]) MatMul(m *Matrix2D[T]) (*Matrix2D[T], error) {
+	if b.n != m.GetShape().Height {
+		return nil, fmt.Errorf("MatMul(...) inner dimension mismatch: %d x %v", b.n, m.GetShape())
+	}
+	cols := m.GetShape().Width
+	result, err := New2D[T](NewShape(cols, b.n))
+	if err != nil {
+		return nil, err
+	}
+	for row := 0; row < b.n; row++ {
+		lo, hi := row-b.k, row+b.k
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > b.n-1 {
+			hi = b.n - 1
+		}
+		for col := 0; col < cols; col++ {
+			var sum T
+			for k := lo; k <= hi; k++ {
+				v, _ := b.At(NewPosition(row, k))
+				sum += v * m.Values[k][col]
+			}
+			result.Values[row][col] = sum
+		}
+	}
+	return result, nil
+}
+
+// Solve solves Bx = rhs for x using the Thomas algorithm: a forward sweep eliminates the sub-diagonal,
+// then back-substitution recovers x. It only supports tridiagonal (bandwidth 1) matrices.
+func (b *Band[T]) Solve(rhs *Matrix2D[T]) (*Matrix2D[T], error) {
+	if b.k != 1 {
+		return nil, fmt.Errorf("Solve() only supports tridiagonal (bandwidth 1) matrices, got bandwidth %d", b.k)
+	}
+	if rhs.GetShape().Height != b.n || rhs.GetShape().Width != 1 {
+		return nil, fmt.Errorf("Solve() expects a column vector b of height %d, got %v", b.n, rhs.GetShape())
+	}
+
+	n := b.n
+	sub, main, super := b.diagonals[0], b.diagonals[1], b.diagonals[2]
+	c := append([]T(nil), main...)
+	r := make([]T, n)
+	for i := 0; i < n; i++ {
+		r[i] = rhs.Values[i][0]
+	}
+
+	// Forward sweep: eliminate the sub-diagonal.
+	for i := 1; i < n; i++ {
+		if c[i-1] == 0 {
+			return nil, fmt.Errorf("Solve() matrix is singular at row %d", i-1)
+		}
+		w := sub[i] / c[i-1]
+		c[i] -= w * super[i-1]
+		r[i] -= w * r[i-1]
+	}
+
+	// Back substitution.
+	if c[n-1] == 0 {
+		return nil, fmt.Errorf("Solve() matrix is singular at row %d", n-1)
+	}
+	x := make([]T, n)
+	x[n-1] = r[n-1] / c[n-1]
+	for i := n - 2; i >= 0; i-- {
+		x[i] = (r[i] - super[i]*x[i+1]) / c[i]
+	}
+
+	result, err := New2D[T](NewShape(1, n))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		result.Values[i][0] = x[i]
+	}
+	return result, nil
+}
+
+func (b *Band[T]) isPositionOutOfBounds(p *Position) bool {
+	return p.Row >= b.n || p.Column >= b.n || p.Row < 0 || p.Column < 0
+}
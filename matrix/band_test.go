@@ -0,0 +1,48 @@
+package matrix_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/matrix"
+	"github.com/stretchr/testify/require"
+)
+
+func tridiagonal(t *testing.T) *matrix.Band[float64] {
+	b := matrix.NewBand[float64](3, 1)
+	require.NoError(t, b.Set(matrix.NewPosition(0, 0), 2))
+	require.NoError(t, b.Set(matrix.NewPosition(0, 1), -1))
+	require.NoError(t, b.Set(matrix.NewPosition(1, 0), -1))
+	require.NoError(t, b.Set(matrix.NewPosition(1, 1), 2))
+	require.NoError(t, b.Set(matrix.NewPosition(1, 2), -1))
+	require.NoError(t, b.Set(matrix.NewPosition(2, 1), -1))
+	require.NoError(t, b.Set(matrix.NewPosition(2, 2), 2))
+	return b
+}
+
+func TestBandSetAtRejectsOutsideBandwidth(t *testing.T) {
+	b := tridiagonal(t)
+	require.Error(t, b.Set(matrix.NewPosition(0, 2), 1))
+
+	v, err := b.At(matrix.NewPosition(0, 2))
+	require.NoError(t, err)
+	require.Equal(t, 0.0, v)
+}
+
+func TestBandSolveThomasAlgorithm(t *testing.T) {
+	b := tridiagonal(t)
+	rhs, err := matrix.New2D[float64](matrix.NewShape(1, 3), matrix.WithData([][]float64{
+		{1},
+		{0},
+		{1},
+	}))
+	require.NoError(t, err)
+
+	x, err := b.Solve(rhs)
+	require.NoError(t, err)
+
+	product, err := b.MatMul(x)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0, product.GetValues()[0][0], 1e-9)
+	require.InDelta(t, 0.0, product.GetValues()[1][0], 1e-9)
+	require.InDelta(t, 1.0, product.GetValues()[2][0], 1e-9)
+}
@@ -0,0 +1,89 @@
+package matrix
+
+import "fmt"
+
+// Diagonal is a square matrix that only stores its diagonal, in O(n) instead of a dense O(n^2)
+// allocation. Every off-diagonal position reads as zero, and writing a non-zero value off the
+// diagonal is rejected.
+type Diagonal[T Number] struct {
+	shape  *Shape
+	values []T
+}
+
+// NewDiagonal constructs a new, zeroed n x n Diagonal matrix.
+func NewDiagonal[T Number](n int) *Diagonal[T] {
+	return &Diagonal[T]{shape: NewShape(n, n), values: make([]T, n)}
+}
+
+// Set assigns value at position (row, column). It returns an error if position is not on the diagonal
+// and value is non-zero.
+func (d *Diagonal[T]) Set(position *Position, value T) error {
+	if d.isPositionOutOfBounds(position) {
+		return fmt.Errorf("out of bound position %v with shape %v", position, d.shape)
+	}
+	if position.Row != position.Column {
+		if value != 0 {
+			return fmt.Errorf("Set(...) position %v is off the diagonal of a Diagonal matrix", position)
+		}
+		return nil
+	}
+	d.values[position.Row] = value
+	return nil
+}
+
+// At returns the value at position (row, column). Off-diagonal positions always read as zero.
+func (d *Diagonal[T]) At(position *Position) (T, error) {
+	if d.isPositionOutOfBounds(position) {
+		return 0, fmt.Errorf("out of bound position %v with shape %v", position, d.shape)
+	}
+	if position.Row != position.Column {
+		return 0, nil
+	}
+	return d.values[position.Row], nil
+}
+
+// GetShape returns a vector representing the dimensionality of the Diagonal as (rows, columns).
+func (d *Diagonal[T]) GetShape() *Shape {
+	return d.shape
+}
+
+// MatMul multiplies this diagonal matrix by b, scaling each row of b by the corresponding diagonal
+// element. This runs in O(n*width) instead of the O(n^2*width) a dense multiplication would take.
+func (d *Diagonal[T]) MatMul(b *Matrix2D[T]) (*Matrix2D[T], error) {
+	if len(d.values) != b.GetShape().Height {
+		return nil, fmt.Errorf("MatMul(...) inner dimension mismatch: %d x %v", len(d.values), b.GetShape())
+	}
+	result, err := New2D[T](b.GetShape())
+	if err != nil {
+		return nil, err
+	}
+	for y := 0; y < b.GetShape().Height; y++ {
+		for x := 0; x < b.GetShape().Width; x++ {
+			result.Values[y][x] = d.values[y] * b.Values[y][x]
+		}
+	}
+	return result, nil
+}
+
+// Solve solves Dx = b for x, where D is this diagonal matrix and b a column vector, by dividing each
+// element of b by the corresponding diagonal element. It returns an error if any diagonal element is zero.
+func (d *Diagonal[T]) Solve(b *Matrix2D[T]) (*Matrix2D[T], error) {
+	if b.GetShape().Height != len(d.values) || b.GetShape().Width != 1 {
+		return nil, fmt.Errorf("Solve() expects a column vector b of height %d, got %v", len(d.values), b.GetShape())
+	}
+	result, err := New2D[T](NewShape(1, len(d.values)))
+	if err != nil {
+		return nil, err
+	}
+	for i, diag := range d.values {
+		if diag == 0 {
+			return nil, fmt.Errorf("Solve() matrix is singular at row %d", i)
+		}
+		result.Values[i][0] = b.Values[i][0] / diag
+	}
+	return result, nil
+}
+
+func (d *Diagonal[T]) isPositionOutOfBounds(p *Position) bool {
+	return p.Row >= d.shape.Height || p.Column >= d.shape.Width || p.Row < 0 || p.Column < 0
+}
@@ -0,0 +1,45 @@
+package matrix_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/matrix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagonalSetAt(t *testing.T) {
+	d := matrix.NewDiagonal[float64](3)
+	require.NoError(t, d.Set(matrix.NewPosition(0, 0), 2))
+	require.NoError(t, d.Set(matrix.NewPosition(1, 1), 3))
+	require.NoError(t, d.Set(matrix.NewPosition(2, 2), 4))
+
+	require.Error(t, d.Set(matrix.NewPosition(0, 1), 5))
+
+	v, err := d.At(matrix.NewPosition(0, 1))
+	require.NoError(t, err)
+	require.Equal(t, 0.0, v)
+}
+
+func TestDiagonalMatMulAndSolve(t *testing.T) {
+	d := matrix.NewDiagonal[float64](2)
+	require.NoError(t, d.Set(matrix.NewPosition(0, 0), 2))
+	require.NoError(t, d.Set(matrix.NewPosition(1, 1), 4))
+
+	b, err := matrix.New2D[float64](matrix.NewShape(1, 2), matrix.WithData([][]float64{
+		{3},
+		{5},
+	}))
+	require.NoError(t, err)
+
+	product, err := d.MatMul(b)
+	require.NoError(t, err)
+	require.Equal(t, [][]float64{
+		{6},
+		{20},
+	}, product.GetValues())
+
+	x, err := d.Solve(b)
+	require.NoError(t, err)
+	require.InDelta(t, 1.5, x.GetValues()[0][0], 1e-9)
+	require.InDelta(t, 1.25, x.GetValues()[1][0], 1e-9)
+}
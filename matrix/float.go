@@ -0,0 +1,232 @@
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/iuga/gomath/vector"
+	"golang.org/x/exp/constraints"
+)
+
+// Float restricts numerically-sensitive operations (Determinant, Inverse, Solve, LU) to floating-point
+// element types, since they rely on division and pivoting that silently truncate on integer types.
+type Float interface {
+	constraints.Float
+}
+
+// FloatMatrix wraps a Matrix2D of floating-point elements to provide the linear algebra operations
+// that require division and pivoting.
+type FloatMatrix[T Float] struct {
+	*Matrix2D[T]
+}
+
+// NewFloat2D constructs a new FloatMatrix from the given shape.
+func NewFloat2D[T Float](shape *Shape, opts ...Matrix2DOpts[T]) (*FloatMatrix[T], error) {
+	m, err := New2D[T](shape, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &FloatMatrix[T]{m}, nil
+}
+
+// LU decomposes this matrix into a lower-triangular L and upper-triangular U such that P*A = L*U,
+// using partial pivoting for numerical stability. piv records the row permutation applied by pivoting.
+// It returns an error if the matrix is not square or a pivot falls below the EPSILON threshold.
+func (m *FloatMatrix[T]) LU() (*Matrix2D[T], *Matrix2D[T], []int, error) {
+	n := m.GetShape().Height
+	if n != m.GetShape().Width {
+		return nil, nil, nil, fmt.Errorf("LU() requires a square matrix, got %v", m.GetShape())
+	}
+
+	u, err := New2D[T](NewShape(n, n))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for y := range m.Values {
+		copy(u.Values[y], m.Values[y])
+	}
+	l, err := New2D[T](NewShape(n, n))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	piv := make([]int, n)
+	for i := range piv {
+		piv[i] = i
+	}
+
+	for k := 0; k < n; k++ {
+		maxRow, maxVal := k, absT(u.Values[k][k])
+		for i := k + 1; i < n; i++ {
+			if v := absT(u.Values[i][k]); v > maxVal {
+				maxRow, maxVal = i, v
+			}
+		}
+		if maxVal < T(vector.EPSILON) {
+			return nil, nil, nil, fmt.Errorf("LU() matrix is singular at pivot %d", k)
+		}
+		if maxRow != k {
+			u.Values[k], u.Values[maxRow] = u.Values[maxRow], u.Values[k]
+			l.Values[k], l.Values[maxRow] = l.Values[maxRow], l.Values[k]
+			piv[k], piv[maxRow] = piv[maxRow], piv[k]
+		}
+
+		l.Values[k][k] = 1
+		for i := k + 1; i < n; i++ {
+			factor := u.Values[i][k] / u.Values[k][k]
+			l.Values[i][k] = factor
+			for j := k; j < n; j++ {
+				u.Values[i][j] -= factor * u.Values[k][j]
+			}
+		}
+	}
+	return l, u, piv, nil
+}
+
+// Determinant computes the determinant of this (square) matrix via LU decomposition: the product of
+// U's diagonal, with a sign flip for every row swap partial pivoting performed. A singular matrix
+// (detected by the pivot threshold in LU) has a determinant of zero.
+func (m *FloatMatrix[T]) Determinant() (T, error) {
+	n := m.GetShape().Height
+	if n != m.GetShape().Width {
+		return 0, fmt.Errorf("Determinant() requires a square matrix, got %v", m.GetShape())
+	}
+	_, u, piv, err := m.LU()
+	if err != nil {
+		return 0, nil
+	}
+	det := T(1)
+	for i := 0; i < n; i++ {
+		det *= u.Values[i][i]
+	}
+	if permutationSwaps(piv)%2 != 0 {
+		det = -det
+	}
+	return det, nil
+}
+
+// Inverse computes the matrix inverse via Gauss-Jordan elimination with partial pivoting: the matrix
+// is augmented with the identity and reduced until the left-hand side becomes the identity, leaving
+// the inverse on the right-hand side. It returns an error on non-square or singular matrices.
+func (m *FloatMatrix[T]) Inverse() (*Matrix2D[T], error) {
+	n := m.GetShape().Height
+	if n != m.GetShape().Width {
+		return nil, fmt.Errorf("Inverse() requires a square matrix, got %v", m.GetShape())
+	}
+
+	aug := make([][]T, n)
+	for i := 0; i < n; i++ {
+		aug[i] = make([]T, 2*n)
+		copy(aug[i], m.Values[i])
+		aug[i][n+i] = 1
+	}
+
+	for k := 0; k < n; k++ {
+		maxRow, maxVal := k, absT(aug[k][k])
+		for i := k + 1; i < n; i++ {
+			if v := absT(aug[i][k]); v > maxVal {
+				maxRow, maxVal = i, v
+			}
+		}
+		if maxVal < T(vector.EPSILON) {
+			return nil, fmt.Errorf("Inverse() matrix is singular at pivot %d", k)
+		}
+		aug[k], aug[maxRow] = aug[maxRow], aug[k]
+
+		pivot := aug[k][k]
+		for j := 0; j < 2*n; j++ {
+			aug[k][j] /= pivot
+		}
+		for i := 0; i < n; i++ {
+			if i == k {
+				continue
+			}
+			factor := aug[i][k]
+			for j := 0; j < 2*n; j++ {
+				aug[i][j] -= factor * aug[k][j]
+			}
+		}
+	}
+
+	inv, err := New2D[T](NewShape(n, n))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		copy(inv.Values[i], aug[i][n:])
+	}
+	return inv, nil
+}
+
+// Solve solves the linear system Ax = b for x, where A is this matrix and b is a column vector, using
+// LU decomposition with partial pivoting followed by forward and back substitution.
+func (m *FloatMatrix[T]) Solve(b *Matrix2D[T]) (*Matrix2D[T], error) {
+	n := m.GetShape().Height
+	if n != m.GetShape().Width {
+		return nil, fmt.Errorf("Solve() requires a square matrix, got %v", m.GetShape())
+	}
+	if b.GetShape().Height != n || b.GetShape().Width != 1 {
+		return nil, fmt.Errorf("Solve() expects a column vector b of height %d, got %v", n, b.GetShape())
+	}
+
+	l, u, piv, err := m.LU()
+	if err != nil {
+		return nil, err
+	}
+
+	pb := make([]T, n)
+	for i, p := range piv {
+		pb[i] = b.Values[p][0]
+	}
+
+	// Forward substitution: L*y = P*b
+	y := make([]T, n)
+	for i := 0; i < n; i++ {
+		sum := pb[i]
+		for j := 0; j < i; j++ {
+			sum -= l.Values[i][j] * y[j]
+		}
+		y[i] = sum
+	}
+
+	// Back substitution: U*x = y
+	x := make([]T, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := y[i]
+		for j := i + 1; j < n; j++ {
+			sum -= u.Values[i][j] * x[j]
+		}
+		x[i] = sum / u.Values[i][i]
+	}
+
+	result, err := New2D[T](NewShape(1, n))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		result.Values[i][0] = x[i]
+	}
+	return result, nil
+}
+
+// absT returns the absolute value of v.
+func absT[T Float](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// permutationSwaps returns the number of transpositions needed to sort the LU pivot permutation back
+// to identity, i.e. the permutation's parity, used to determine the sign of the determinant.
+func permutationSwaps(piv []int) int {
+	p := append([]int(nil), piv...)
+	swaps := 0
+	for i := range p {
+		for p[i] != i {
+			j := p[i]
+			p[i], p[j] = p[j], p[i]
+			swaps++
+		}
+	}
+	return swaps
+}
@@ -0,0 +1,73 @@
+package matrix_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/matrix"
+	"github.com/iuga/gomath/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLUAndDeterminant(t *testing.T) {
+	a, err := matrix.NewFloat2D[float64](matrix.NewShape(3, 3), matrix.WithData([][]float64{
+		{4, 3, 2},
+		{2, 1, 3},
+		{3, 2, 1},
+	}))
+	require.NoError(t, err)
+
+	l, u, piv, err := a.LU()
+	require.NoError(t, err)
+	require.NotNil(t, l)
+	require.NotNil(t, u)
+	require.Len(t, piv, 3)
+
+	det, err := a.Determinant()
+	require.NoError(t, err)
+	require.InDelta(t, 3.0, det, vector.EPSILON)
+}
+
+func TestInverse(t *testing.T) {
+	a, err := matrix.NewFloat2D[float64](matrix.NewShape(2, 2), matrix.WithData([][]float64{
+		{4, 7},
+		{2, 6},
+	}))
+	require.NoError(t, err)
+
+	inv, err := a.Inverse()
+	require.NoError(t, err)
+
+	identity, err := inv.MatMul(a.Matrix2D)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0, identity.GetValues()[0][0], vector.EPSILON)
+	require.InDelta(t, 0.0, identity.GetValues()[0][1], vector.EPSILON)
+	require.InDelta(t, 0.0, identity.GetValues()[1][0], vector.EPSILON)
+	require.InDelta(t, 1.0, identity.GetValues()[1][1], vector.EPSILON)
+
+	singular, err := matrix.NewFloat2D[float64](matrix.NewShape(2, 2), matrix.WithData([][]float64{
+		{1, 2},
+		{2, 4},
+	}))
+	require.NoError(t, err)
+	_, err = singular.Inverse()
+	require.Error(t, err)
+}
+
+func TestSolve(t *testing.T) {
+	a, err := matrix.NewFloat2D[float64](matrix.NewShape(2, 2), matrix.WithData([][]float64{
+		{2, 1},
+		{1, 3},
+	}))
+	require.NoError(t, err)
+
+	b, err := matrix.New2D[float64](matrix.NewShape(1, 2), matrix.WithData([][]float64{
+		{3},
+		{5},
+	}))
+	require.NoError(t, err)
+
+	x, err := a.Solve(b)
+	require.NoError(t, err)
+	require.InDelta(t, 0.8, x.GetValues()[0][0], 1e-9)
+	require.InDelta(t, 1.4, x.GetValues()[1][0], 1e-9)
+}
@@ -0,0 +1,123 @@
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/iuga/gomath/vector"
+)
+
+// MatMul multiplies this matrix (h x w) by b (w x p), returning the resulting (h x p) product.
+// It returns an error if the inner dimensions do not match.
+func (m Matrix2D[T]) MatMul(b *Matrix2D[T]) (*Matrix2D[T], error) {
+	if m.GetShape().Width != b.GetShape().Height {
+		return nil, fmt.Errorf("MatMul(...) inner dimension mismatch: %v x %v", m.GetShape(), b.GetShape())
+	}
+	rows, cols, inner := m.GetShape().Height, b.GetShape().Width, m.GetShape().Width
+	result, err := New2D[T](NewShape(cols, rows))
+	if err != nil {
+		return nil, err
+	}
+	for y := 0; y < rows; y++ {
+		for x := 0; x < cols; x++ {
+			var sum T
+			for k := 0; k < inner; k++ {
+				sum += m.Values[y][k] * b.Values[k][x]
+			}
+			result.Values[y][x] = sum
+		}
+	}
+	return result, nil
+}
+
+// Transpose returns a new matrix with rows and columns swapped.
+func (m Matrix2D[T]) Transpose() *Matrix2D[T] {
+	result, _ := New2D[T](NewShape(m.GetShape().Height, m.GetShape().Width))
+	for y := 0; y < m.GetShape().Height; y++ {
+		for x := 0; x < m.GetShape().Width; x++ {
+			result.Values[x][y] = m.Values[y][x]
+		}
+	}
+	return result
+}
+
+// Add returns the element-wise sum of this matrix and b. It returns an error if the shapes do not match.
+func (m Matrix2D[T]) Add(b *Matrix2D[T]) (*Matrix2D[T], error) {
+	if m.GetShape().Width != b.GetShape().Width || m.GetShape().Height != b.GetShape().Height {
+		return nil, fmt.Errorf("Add(...) shape mismatch: %v vs %v", m.GetShape(), b.GetShape())
+	}
+	result, _ := New2D[T](m.GetShape())
+	for y := range m.Values {
+		for x := range m.Values[y] {
+			result.Values[y][x] = m.Values[y][x] + b.Values[y][x]
+		}
+	}
+	return result, nil
+}
+
+// Sub returns the element-wise difference of this matrix and b. It returns an error if the shapes do not match.
+func (m Matrix2D[T]) Sub(b *Matrix2D[T]) (*Matrix2D[T], error) {
+	if m.GetShape().Width != b.GetShape().Width || m.GetShape().Height != b.GetShape().Height {
+		return nil, fmt.Errorf("Sub(...) shape mismatch: %v vs %v", m.GetShape(), b.GetShape())
+	}
+	result, _ := New2D[T](m.GetShape())
+	for y := range m.Values {
+		for x := range m.Values[y] {
+			result.Values[y][x] = m.Values[y][x] - b.Values[y][x]
+		}
+	}
+	return result, nil
+}
+
+// Scale returns this matrix with every element multiplied by k.
+func (m Matrix2D[T]) Scale(k T) *Matrix2D[T] {
+	result, _ := New2D[T](m.GetShape())
+	for y := range m.Values {
+		for x := range m.Values[y] {
+			result.Values[y][x] = m.Values[y][x] * k
+		}
+	}
+	return result
+}
+
+// Identity returns a new n x n identity matrix with ones on the diagonal.
+func Identity[T Number](n int) (*Matrix2D[T], error) {
+	m, err := New2D[T](NewShape(n, n))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		m.Values[i][i] = 1
+	}
+	return m, nil
+}
+
+// ApplyVector applies this 2x2 matrix as a linear transform to v and returns the transformed vector.
+// This lets 2x2 transformation matrices be composed with the Vector2D Rotate/Scale operations. It
+// returns an error if the matrix is not 2x2.
+func (m Matrix2D[T]) ApplyVector(v *vector.Vector2D[T]) (*vector.Vector2D[T], error) {
+	if m.GetShape().Width != 2 || m.GetShape().Height != 2 {
+		return nil, fmt.Errorf("ApplyVector(...) requires a 2x2 matrix, got %v", m.GetShape())
+	}
+	return vector.New2D[T](
+		m.Values[0][0]*v.X+m.Values[0][1]*v.Y,
+		m.Values[1][0]*v.X+m.Values[1][1]*v.Y,
+	), nil
+}
+
+// ApplyVectorN applies this matrix as a linear transform to v, a VectorN of matching dimension (the
+// 2-dimensional case behaves exactly like ApplyVector). This is the VectorN counterpart of
+// ApplyVector, so a Matrix2D transform can be composed with either vector shape.
+func (m Matrix2D[T]) ApplyVectorN(v *vector.VectorN[T]) (*vector.VectorN[T], error) {
+	if v.Dimension() != m.GetShape().Width {
+		return nil, fmt.Errorf("ApplyVectorN(...) dimension mismatch: matrix width %d vs vector dimension %d", m.GetShape().Width, v.Dimension())
+	}
+	result := make([]T, m.GetShape().Height)
+	for y := 0; y < m.GetShape().Height; y++ {
+		var sum T
+		for x, c := range v.Values {
+			sum += m.Values[y][x] * c
+		}
+		result[y] = sum
+	}
+	return vector.NewN[T](result...), nil
+}
@@ -0,0 +1,119 @@
+package matrix_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/matrix"
+	"github.com/iuga/gomath/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatMul(t *testing.T) {
+	a, err := matrix.New2D[int](matrix.NewShape(3, 2), matrix.WithData([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	}))
+	require.NoError(t, err)
+
+	b, err := matrix.New2D[int](matrix.NewShape(2, 3), matrix.WithData([][]int{
+		{7, 8},
+		{9, 10},
+		{11, 12},
+	}))
+	require.NoError(t, err)
+
+	c, err := a.MatMul(b)
+	require.NoError(t, err)
+	require.Equal(t, [][]int{
+		{58, 64},
+		{139, 154},
+	}, c.GetValues())
+
+	_, err = b.MatMul(b)
+	require.Error(t, err)
+}
+
+func TestTransposeAndIdentity(t *testing.T) {
+	a, err := matrix.New2D[int](matrix.NewShape(3, 2), matrix.WithData([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	}))
+	require.NoError(t, err)
+
+	require.Equal(t, [][]int{
+		{1, 4},
+		{2, 5},
+		{3, 6},
+	}, a.Transpose().GetValues())
+
+	id, err := matrix.Identity[int](3)
+	require.NoError(t, err)
+	require.Equal(t, [][]int{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+	}, id.GetValues())
+}
+
+func TestAddSubScale(t *testing.T) {
+	a, err := matrix.New2D[int](matrix.NewShape(2, 2), matrix.WithData([][]int{
+		{1, 2},
+		{3, 4},
+	}))
+	require.NoError(t, err)
+
+	b, err := matrix.New2D[int](matrix.NewShape(2, 2), matrix.WithConstant[int](1))
+	require.NoError(t, err)
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	require.Equal(t, [][]int{
+		{2, 3},
+		{4, 5},
+	}, sum.GetValues())
+
+	diff, err := a.Sub(b)
+	require.NoError(t, err)
+	require.Equal(t, [][]int{
+		{0, 1},
+		{2, 3},
+	}, diff.GetValues())
+
+	require.Equal(t, [][]int{
+		{2, 4},
+		{6, 8},
+	}, a.Scale(2).GetValues())
+}
+
+func TestApplyVector(t *testing.T) {
+	rot90, err := matrix.New2D[float64](matrix.NewShape(2, 2), matrix.WithData([][]float64{
+		{0, -1},
+		{1, 0},
+	}))
+	require.NoError(t, err)
+
+	v, err := rot90.ApplyVector(vector.New2D[float64](1, 0))
+	require.NoError(t, err)
+	require.InDelta(t, 0.0, v.X, vector.EPSILON)
+	require.InDelta(t, 1.0, v.Y, vector.EPSILON)
+
+	notTwoByTwo, err := matrix.New2D[float64](matrix.NewShape(3, 3))
+	require.NoError(t, err)
+	_, err = notTwoByTwo.ApplyVector(vector.New2D[float64](1, 0))
+	require.Error(t, err)
+}
+
+func TestApplyVectorN(t *testing.T) {
+	rot90, err := matrix.New2D[float64](matrix.NewShape(2, 2), matrix.WithData([][]float64{
+		{0, -1},
+		{1, 0},
+	}))
+	require.NoError(t, err)
+
+	result, err := rot90.ApplyVectorN(vector.NewN[float64](1, 0))
+	require.NoError(t, err)
+	require.Equal(t, []float64{0, 1}, result.Values)
+
+	_, err = rot90.ApplyVectorN(vector.NewN[float64](1, 0, 0))
+	require.Error(t, err)
+}
@@ -0,0 +1,13 @@
+package matrix
+
+// Matrix is the common read/write interface implemented by every matrix storage variant in this
+// package (Matrix2D, Sparse2D, Diagonal, Band), so downstream code (curve rasterization, rect grids)
+// can stay agnostic to which storage strategy backs a given matrix.
+type Matrix[T Number] interface {
+	// Set assigns value at position (row, column).
+	Set(position *Position, value T) error
+	// At returns the value at position (row, column).
+	At(position *Position) (T, error)
+	// GetShape returns the matrix's dimensions.
+	GetShape() *Shape
+}
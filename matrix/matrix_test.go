@@ -0,0 +1,19 @@
+package matrix_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/matrix"
+)
+
+// Compile-time assertions that every storage variant implements the common Matrix interface.
+var (
+	_ matrix.Matrix[int]     = (*matrix.Matrix2D[int])(nil)
+	_ matrix.Matrix[int]     = (*matrix.Sparse2D[int])(nil)
+	_ matrix.Matrix[float64] = (*matrix.Diagonal[float64])(nil)
+	_ matrix.Matrix[float64] = (*matrix.Band[float64])(nil)
+)
+
+func TestMatrixInterfaceSatisfied(t *testing.T) {
+	// Asserted at compile time above; this test exists so `go test` still exercises the package.
+}
@@ -0,0 +1,190 @@
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/iuga/gomath/vector"
+)
+
+// denseThreshold is the density above which Sparse2D arithmetic falls back to converting through
+// Matrix2D: past this point a COO scan visits about as many cells as a flat array would, so the dense
+// algorithm (and its better cache behavior) wins.
+const denseThreshold = 0.5
+
+// Sparse2D is a coordinate-list (COO) matrix that only stores its non-zero elements. It implements
+// the same Set/At/GetShape API as Matrix2D, but allocates O(nnz) instead of O(width*height), which
+// matters when Matrix2D is used as a largely-empty game board or collision grid.
+type Sparse2D[T Number] struct {
+	shape  *Shape
+	values map[Position]T
+}
+
+// NewSparse2D constructs a new, empty Sparse2D of the given shape.
+func NewSparse2D[T Number](shape *Shape) *Sparse2D[T] {
+	return &Sparse2D[T]{shape: shape, values: make(map[Position]T)}
+}
+
+// FromDense builds a Sparse2D from a dense Matrix2D, storing only its non-zero elements.
+func FromDense[T Number](m *Matrix2D[T]) *Sparse2D[T] {
+	s := NewSparse2D[T](m.GetShape())
+	for y, row := range m.Values {
+		for x, v := range row {
+			if v != 0 {
+				s.values[Position{Row: y, Column: x}] = v
+			}
+		}
+	}
+	return s
+}
+
+// Set assigns value at position (row, column). Setting a position to the zero value removes it from
+// the underlying storage rather than keeping an explicit zero entry.
+func (s *Sparse2D[T]) Set(position *Position, value T) error {
+	if s.isPositionOutOfBounds(position) {
+		return fmt.Errorf("out of bound position %v with shape %v", position, s.shape)
+	}
+	if value == 0 {
+		delete(s.values, *position)
+		return nil
+	}
+	s.values[*position] = value
+	return nil
+}
+
+// At returns the value at position (row, column), or the zero value if nothing is stored there.
+func (s *Sparse2D[T]) At(position *Position) (T, error) {
+	if s.isPositionOutOfBounds(position) {
+		return 0, fmt.Errorf("out of bound position %v with shape %v", position, s.shape)
+	}
+	return s.values[*position], nil
+}
+
+// GetShape returns a vector representing the dimensionality of the Sparse2D as (rows, columns).
+func (s *Sparse2D[T]) GetShape() *Shape {
+	return s.shape
+}
+
+// Density returns the fraction of cells that hold a non-zero value, in [0, 1].
+func (s *Sparse2D[T]) Density() float64 {
+	total := s.shape.Width * s.shape.Height
+	if total == 0 {
+		return 0
+	}
+	return float64(len(s.values)) / float64(total)
+}
+
+// ToDense converts this sparse matrix into an equivalent dense Matrix2D.
+func (s *Sparse2D[T]) ToDense() (*Matrix2D[T], error) {
+	m, err := New2D[T](s.shape)
+	if err != nil {
+		return nil, err
+	}
+	for pos, v := range s.values {
+		pos := pos
+		if err := m.Set(&pos, v); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Add returns the element-wise sum of this matrix and other, accumulated directly over their non-zero
+// entries. Callers combining a sparse matrix with a dense one should convert via ToDense/FromDense first.
+func (s *Sparse2D[T]) Add(other *Sparse2D[T]) (*Sparse2D[T], error) {
+	if s.shape.Width != other.shape.Width || s.shape.Height != other.shape.Height {
+		return nil, fmt.Errorf("Add(...) shape mismatch: %v vs %v", s.shape, other.shape)
+	}
+	result := NewSparse2D[T](s.shape)
+	for pos, v := range s.values {
+		result.values[pos] = v
+	}
+	for pos, v := range other.values {
+		result.values[pos] += v
+		if result.values[pos] == 0 {
+			delete(result.values, pos)
+		}
+	}
+	return result, nil
+}
+
+// Slice returns a subset of the matrix as a new Sparse2D, re-indexed from (0, 0), taking the columns
+// in range x and the rows in range y (both inclusive on both ends). Mirrors Matrix2D.Slice.
+func (s *Sparse2D[T]) Slice(x *vector.Vector2D[int], y *vector.Vector2D[int]) (*Sparse2D[T], error) {
+	shape := &Shape{Width: x.Y + 1 - x.X, Height: y.Y + 1 - y.X}
+	if shape.Width < 0 || shape.Height < 0 || shape.Width > s.shape.Width || shape.Height > s.shape.Height {
+		return nil, fmt.Errorf("slice %v out of bounds on matrix of shape %v", shape, s.shape)
+	}
+	result := NewSparse2D[T](shape)
+	for pos, v := range s.values {
+		if pos.Column >= x.X && pos.Column <= x.Y && pos.Row >= y.X && pos.Row <= y.Y {
+			result.values[Position{Row: pos.Row - y.X, Column: pos.Column - x.X}] = v
+		}
+	}
+	return result, nil
+}
+
+// Update the matrix data with another matrix starting in position x,y. Mirrors Matrix2D.Update.
+func (s *Sparse2D[T]) Update(position *Position, other *Sparse2D[T]) error {
+	if s.isPositionOutOfBounds(position) {
+		return fmt.Errorf("update starting position %v is out of bounds %v", position, s.shape)
+	}
+	if position.Column+other.GetShape().Width > s.shape.Width || position.Row+other.GetShape().Height > s.shape.Height {
+		return fmt.Errorf("slide to update is out of bounds on: %v base: %v update: %v", position, s.shape, other.shape)
+	}
+	// Clear the destination window first so positions the update leaves as zero don't keep stale values.
+	for row := position.Row; row < position.Row+other.GetShape().Height; row++ {
+		for col := position.Column; col < position.Column+other.GetShape().Width; col++ {
+			delete(s.values, Position{Row: row, Column: col})
+		}
+	}
+	for pos, v := range other.values {
+		target := Position{Row: pos.Row + position.Row, Column: pos.Column + position.Column}
+		if err := s.Set(&target, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MatMul multiplies this sparse matrix by b. Below denseThreshold it only visits pairs of non-zero
+// entries that share the contracted index; above it, it falls back to Matrix2D.MatMul via ToDense.
+func (s *Sparse2D[T]) MatMul(b *Sparse2D[T]) (*Sparse2D[T], error) {
+	if s.shape.Width != b.shape.Height {
+		return nil, fmt.Errorf("MatMul(...) inner dimension mismatch: %v x %v", s.shape, b.shape)
+	}
+
+	if s.Density() > denseThreshold || b.Density() > denseThreshold {
+		sd, err := s.ToDense()
+		if err != nil {
+			return nil, err
+		}
+		bd, err := b.ToDense()
+		if err != nil {
+			return nil, err
+		}
+		product, err := sd.MatMul(bd)
+		if err != nil {
+			return nil, err
+		}
+		return FromDense(product), nil
+	}
+
+	result := NewSparse2D[T](NewShape(b.shape.Width, s.shape.Height))
+	for posA, va := range s.values {
+		for posB, vb := range b.values {
+			if posA.Column != posB.Row {
+				continue
+			}
+			target := Position{Row: posA.Row, Column: posB.Column}
+			result.values[target] += va * vb
+			if result.values[target] == 0 {
+				delete(result.values, target)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (s *Sparse2D[T]) isPositionOutOfBounds(p *Position) bool {
+	return p.Row >= s.shape.Height || p.Column >= s.shape.Width || p.Row < 0 || p.Column < 0
+}
@@ -0,0 +1,123 @@
+package matrix_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/matrix"
+	"github.com/iuga/gomath/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparse2DSetAtAndDensity(t *testing.T) {
+	s := matrix.NewSparse2D[int](matrix.NewShape(4, 4))
+	require.NoError(t, s.Set(matrix.NewPosition(1, 1), 5))
+	require.NoError(t, s.Set(matrix.NewPosition(2, 2), 7))
+
+	v, err := s.At(matrix.NewPosition(1, 1))
+	require.NoError(t, err)
+	require.Equal(t, 5, v)
+
+	v, err = s.At(matrix.NewPosition(0, 0))
+	require.NoError(t, err)
+	require.Equal(t, 0, v)
+
+	require.InDelta(t, 2.0/16.0, s.Density(), 1e-9)
+}
+
+func TestSparse2DDenseConversions(t *testing.T) {
+	dense, err := matrix.New2D[int](matrix.NewShape(3, 3), matrix.WithData([][]int{
+		{0, 1, 0},
+		{0, 0, 2},
+		{3, 0, 0},
+	}))
+	require.NoError(t, err)
+
+	sparse := matrix.FromDense(dense)
+	require.InDelta(t, 3.0/9.0, sparse.Density(), 1e-9)
+
+	back, err := sparse.ToDense()
+	require.NoError(t, err)
+	require.Equal(t, dense.GetValues(), back.GetValues())
+}
+
+func TestSparse2DAdd(t *testing.T) {
+	a := matrix.NewSparse2D[int](matrix.NewShape(2, 2))
+	require.NoError(t, a.Set(matrix.NewPosition(0, 0), 1))
+
+	b := matrix.NewSparse2D[int](matrix.NewShape(2, 2))
+	require.NoError(t, b.Set(matrix.NewPosition(0, 0), -1))
+	require.NoError(t, b.Set(matrix.NewPosition(1, 1), 4))
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+
+	dense, err := sum.ToDense()
+	require.NoError(t, err)
+	require.Equal(t, [][]int{
+		{0, 0},
+		{0, 4},
+	}, dense.GetValues())
+}
+
+func TestSparse2DSlice(t *testing.T) {
+	s := matrix.NewSparse2D[int](matrix.NewShape(4, 4))
+	require.NoError(t, s.Set(matrix.NewPosition(0, 0), 1))
+	require.NoError(t, s.Set(matrix.NewPosition(1, 1), 1))
+	require.NoError(t, s.Set(matrix.NewPosition(2, 2), 1))
+	require.NoError(t, s.Set(matrix.NewPosition(3, 3), 1))
+
+	sliced, err := s.Slice(vector.New2D[int](1, 3), vector.New2D[int](0, 1))
+	require.NoError(t, err)
+
+	dense, err := sliced.ToDense()
+	require.NoError(t, err)
+	require.Equal(t, [][]int{
+		{0, 0, 0},
+		{1, 0, 0},
+	}, dense.GetValues())
+}
+
+func TestSparse2DUpdate(t *testing.T) {
+	s := matrix.NewSparse2D[int](matrix.NewShape(4, 4))
+	require.NoError(t, s.Set(matrix.NewPosition(0, 0), 9))
+
+	update := matrix.NewSparse2D[int](matrix.NewShape(2, 2))
+	require.NoError(t, update.Set(matrix.NewPosition(0, 0), 1))
+	require.NoError(t, update.Set(matrix.NewPosition(1, 1), 1))
+
+	require.NoError(t, s.Update(matrix.NewPosition(1, 1), update))
+
+	dense, err := s.ToDense()
+	require.NoError(t, err)
+	require.Equal(t, [][]int{
+		{9, 0, 0, 0},
+		{0, 1, 0, 0},
+		{0, 0, 1, 0},
+		{0, 0, 0, 0},
+	}, dense.GetValues())
+
+	require.Error(t, s.Update(matrix.NewPosition(3, 3), update))
+}
+
+func TestSparse2DMatMul(t *testing.T) {
+	a := matrix.NewSparse2D[int](matrix.NewShape(3, 2))
+	require.NoError(t, a.Set(matrix.NewPosition(0, 0), 1))
+	require.NoError(t, a.Set(matrix.NewPosition(1, 2), 2))
+
+	b := matrix.NewSparse2D[int](matrix.NewShape(2, 3))
+	require.NoError(t, b.Set(matrix.NewPosition(0, 0), 3))
+	require.NoError(t, b.Set(matrix.NewPosition(2, 1), 4))
+
+	product, err := a.MatMul(b)
+	require.NoError(t, err)
+
+	dense, err := product.ToDense()
+	require.NoError(t, err)
+	require.Equal(t, [][]int{
+		{3, 0},
+		{0, 8},
+	}, dense.GetValues())
+
+	_, err = b.MatMul(b)
+	require.Error(t, err)
+}
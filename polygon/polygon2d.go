@@ -0,0 +1,158 @@
+package polygon
+
+import (
+	"github.com/iuga/gomath/vector"
+	"golang.org/x/exp/constraints"
+)
+
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Polygon2D represents an arbitrary ordered set of vertices positioned at an origin in 2D space.
+// Vertices are stored relative to Origin, in either winding order.
+type Polygon2D[T Number] struct {
+	// Origin is the polygon's position in world space.
+	Origin *vector.Vector2D[T] `json:"origin"`
+	// Vertices are the polygon's points, relative to Origin, in order.
+	Vertices []*vector.Vector2D[T] `json:"vertices"`
+}
+
+// New2D constructs a new Polygon2D from the given origin and ordered vertices.
+func New2D[T Number](origin *vector.Vector2D[T], vertices []*vector.Vector2D[T]) *Polygon2D[T] {
+	return &Polygon2D[T]{Origin: origin, Vertices: vertices}
+}
+
+// WorldVertices returns the polygon's vertices translated into world space (Origin + vertex).
+func (p *Polygon2D[T]) WorldVertices() []*vector.Vector2D[T] {
+	world := make([]*vector.Vector2D[T], len(p.Vertices))
+	for i, v := range p.Vertices {
+		world[i] = p.Origin.Add(v)
+	}
+	return world
+}
+
+// ProjectOnAxis projects every vertex of the polygon onto axis and returns the resulting [min, max] interval.
+func (p *Polygon2D[T]) ProjectOnAxis(axis *vector.Vector2D[T]) (T, T) {
+	world := p.WorldVertices()
+	min, max := world[0].Dot(axis), world[0].Dot(axis)
+	for _, v := range world[1:] {
+		d := v.Dot(axis)
+		if d < min {
+			min = d
+		}
+		if d > max {
+			max = d
+		}
+	}
+	return min, max
+}
+
+// PointInPolygon returns true if point lies inside the polygon, using the even-odd raycast rule.
+func (p *Polygon2D[T]) PointInPolygon(point *vector.Vector2D[T]) bool {
+	world := p.WorldVertices()
+	inside := false
+	for i, j := 0, len(world)-1; i < len(world); j, i = i, i+1 {
+		vi, vj := world[i], world[j]
+		if (vi.Y > point.Y) != (vj.Y > point.Y) &&
+			point.X < vi.X+(vj.X-vi.X)*(point.Y-vi.Y)/(vj.Y-vi.Y) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// center returns the arithmetic mean of the polygon's world-space vertices.
+func (p *Polygon2D[T]) center() *vector.Vector2D[T] {
+	world := p.WorldVertices()
+	var sx, sy T
+	for _, v := range world {
+		sx += v.X
+		sy += v.Y
+	}
+	n := T(len(world))
+	return vector.New2D[T](sx/n, sy/n)
+}
+
+// Float restricts the SAT collision math (axes, Intersects, PolyPoly) to floating-point element types.
+// Normalizing an edge normal divides by its length, which silently truncates to a zero vector for an
+// integer T, so this math needs the same generic/float split the matrix package uses between Matrix2D and FloatMatrix.
+type Float interface {
+	constraints.Float
+}
+
+// FloatPolygon wraps a Polygon2D of floating-point vertices to provide the SAT collision queries that
+// require normalized axes.
+type FloatPolygon[T Float] struct {
+	*Polygon2D[T]
+}
+
+// NewFloat2D constructs a new FloatPolygon from the given origin and ordered vertices.
+func NewFloat2D[T Float](origin *vector.Vector2D[T], vertices []*vector.Vector2D[T]) *FloatPolygon[T] {
+	return &FloatPolygon[T]{New2D[T](origin, vertices)}
+}
+
+// axes returns the unit-length edge normals of the polygon, used as the SAT separating axes. They
+// must be normalized: ProjectOnAxis and the overlap math in Intersects assume a unit axis, otherwise
+// overlap amounts (and so the resulting MTV) would be scaled by each edge's length.
+func (p *FloatPolygon[T]) axes() []*vector.Vector2D[T] {
+	world := p.WorldVertices()
+	axes := make([]*vector.Vector2D[T], len(world))
+	for i := range world {
+		edge := world[(i+1)%len(world)].Subtract(world[i])
+		axes[i] = edge.NinetyClockwise().Normalized()
+	}
+	return axes
+}
+
+// Intersects runs the Separating Axis Theorem against other. It returns whether the polygons overlap
+// and, when they do, the minimum translation vector (MTV) needed to push this polygon out of other.
+func (p *FloatPolygon[T]) Intersects(other *FloatPolygon[T]) (bool, *vector.Vector2D[T]) {
+	axes := append(p.axes(), other.axes()...)
+
+	var overlap T
+	var smallest *vector.Vector2D[T]
+
+	for _, axis := range axes {
+		aMin, aMax := p.ProjectOnAxis(axis)
+		bMin, bMax := other.ProjectOnAxis(axis)
+		if aMax < bMin || bMax < aMin {
+			return false, nil
+		}
+		o := aMax - bMin
+		if bMax-aMin < o {
+			o = bMax - aMin
+		}
+		if smallest == nil || o < overlap {
+			overlap = o
+			smallest = axis
+		}
+	}
+
+	d := p.center().Subtract(other.center())
+	if d.Dot(smallest) < 0 {
+		smallest = smallest.Negate()
+	}
+	return true, smallest.Normalized().Scale(overlap)
+}
+
+// PolyPoly returns whether polygon p1, displaced by velocity v1, will contact p2 this step.
+// It projects both polygons onto every edge normal and checks whether p1's swept interval, widened by
+// v1's component on that axis, still overlaps p2's interval.
+func PolyPoly[T Float](p1 *FloatPolygon[T], v1 *vector.Vector2D[T], p2 *FloatPolygon[T]) bool {
+	axes := append(p1.axes(), p2.axes()...)
+	for _, axis := range axes {
+		minA, maxA := p1.ProjectOnAxis(axis)
+		minB, maxB := p2.ProjectOnAxis(axis)
+		speed := v1.Dot(axis)
+		if speed < 0 {
+			minA += speed
+		} else {
+			maxA += speed
+		}
+		if maxA < minB || maxB < minA {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package polygon_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/polygon"
+	"github.com/iuga/gomath/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func square(origin *vector.Vector2D[float64], side float64) *polygon.FloatPolygon[float64] {
+	return rectangle(origin, side, side)
+}
+
+func rectangle(origin *vector.Vector2D[float64], width, height float64) *polygon.FloatPolygon[float64] {
+	return polygon.NewFloat2D[float64](origin, []*vector.Vector2D[float64]{
+		vector.New2D[float64](0, 0),
+		vector.New2D[float64](width, 0),
+		vector.New2D[float64](width, height),
+		vector.New2D[float64](0, height),
+	})
+}
+
+func TestPointInPolygon(t *testing.T) {
+	p := square(vector.New2D[float64](0, 0), 4)
+	require.True(t, p.PointInPolygon(vector.New2D[float64](2, 2)))
+	require.False(t, p.PointInPolygon(vector.New2D[float64](5, 5)))
+}
+
+func TestPointInPolygonInteger(t *testing.T) {
+	// Polygon2D itself stays generic over Number (int included); only the SAT math that needs a
+	// normalized axis (FloatPolygon) is restricted to float element types.
+	p := polygon.New2D[int](vector.New2D[int](0, 0), []*vector.Vector2D[int]{
+		vector.New2D[int](0, 0),
+		vector.New2D[int](4, 0),
+		vector.New2D[int](4, 4),
+		vector.New2D[int](0, 4),
+	})
+	require.True(t, p.PointInPolygon(vector.New2D[int](2, 2)))
+	require.False(t, p.PointInPolygon(vector.New2D[int](5, 5)))
+}
+
+func TestPolygonIntersects(t *testing.T) {
+	a := square(vector.New2D[float64](0, 0), 4)
+	b := square(vector.New2D[float64](2, 2), 4)
+	c := square(vector.New2D[float64](10, 10), 4)
+
+	hit, mtv := a.Intersects(b)
+	require.True(t, hit)
+	require.NotNil(t, mtv)
+
+	hit, mtv = a.Intersects(c)
+	require.False(t, hit)
+	require.Nil(t, mtv)
+}
+
+func TestPolygonIntersectsMTVMagnitude(t *testing.T) {
+	a := rectangle(vector.New2D[float64](0, 0), 10, 1)
+	b := rectangle(vector.New2D[float64](0, 0.5), 10, 1)
+
+	hit, mtv := a.Intersects(b)
+	require.True(t, hit)
+	require.True(t, mtv.ApproxEquals(vector.New2D[float64](0, -0.5), vector.EPSILON))
+}
+
+func TestPolyPoly(t *testing.T) {
+	a := square(vector.New2D[float64](0, 0), 4)
+	b := square(vector.New2D[float64](10, 0), 4)
+
+	require.False(t, polygon.PolyPoly(a, vector.New2D[float64](1, 0), b))
+	require.True(t, polygon.PolyPoly(a, vector.New2D[float64](10, 0), b))
+}
@@ -1,6 +1,7 @@
 package rect
 
 import (
+	"math"
 	"math/rand"
 
 	"github.com/iuga/gomath/vector"
@@ -86,9 +87,122 @@ func (r *Rect2D[T]) GetRandomPoint() *vector.Vector2D[T] {
 	)
 }
 
+// Intersects returns true if this rectangle overlaps with other. Rectangles that only share an edge or a
+// corner do not count as intersecting.
+func (r *Rect2D[T]) Intersects(other *Rect2D[T]) bool {
+	if r.Size.X < 0 || r.Size.Y < 0 || other.Size.X < 0 || other.Size.Y < 0 {
+		return false
+	}
+	if r.Position.X >= other.Position.X+other.Size.X || other.Position.X >= r.Position.X+r.Size.X {
+		return false
+	}
+	if r.Position.Y >= other.Position.Y+other.Size.Y || other.Position.Y >= r.Position.Y+r.Size.Y {
+		return false
+	}
+	return true
+}
+
+// Intersection returns the overlapping section of this rectangle and other, or nil if they do not intersect.
+func (r *Rect2D[T]) Intersection(other *Rect2D[T]) *Rect2D[T] {
+	if !r.Intersects(other) {
+		return nil
+	}
+	x1 := r.max(r.Position.X, other.Position.X)
+	y1 := r.max(r.Position.Y, other.Position.Y)
+	x2 := r.min(r.Position.X+r.Size.X, other.Position.X+other.Size.X)
+	y2 := r.min(r.Position.Y+r.Size.Y, other.Position.Y+other.Size.Y)
+	return New2D[T](
+		vector.New2D[T](x1, y1),
+		vector.New2D[T](x2-x1, y2-y1),
+	)
+}
+
+// Union returns the smallest rectangle that encloses both this rectangle and other.
+func (r *Rect2D[T]) Union(other *Rect2D[T]) *Rect2D[T] {
+	x1 := r.min(r.Position.X, other.Position.X)
+	y1 := r.min(r.Position.Y, other.Position.Y)
+	x2 := r.max(r.Position.X+r.Size.X, other.Position.X+other.Size.X)
+	y2 := r.max(r.Position.Y+r.Size.Y, other.Position.Y+other.Size.Y)
+	return New2D[T](
+		vector.New2D[T](x1, y1),
+		vector.New2D[T](x2-x1, y2-y1),
+	)
+}
+
+// Encloses returns true if this rectangle completely encloses other.
+func (r *Rect2D[T]) Encloses(other *Rect2D[T]) bool {
+	return other.Position.X >= r.Position.X &&
+		other.Position.Y >= r.Position.Y &&
+		other.Position.X+other.Size.X <= r.Position.X+r.Size.X &&
+		other.Position.Y+other.Size.Y <= r.Position.Y+r.Size.Y
+}
+
+// Expand returns a copy of this rectangle grown by by on every side, keeping it centered on the same point.
+// A negative by shrinks the rectangle instead.
+func (r *Rect2D[T]) Expand(by T) *Rect2D[T] {
+	return New2D[T](
+		vector.New2D[T](r.Position.X-by, r.Position.Y-by),
+		vector.New2D[T](r.Size.X+by*2, r.Size.Y+by*2),
+	)
+}
+
+// Grow returns a copy of this rectangle grown independently on X and Y by the components of by.
+func (r *Rect2D[T]) Grow(by *vector.Vector2D[T]) *Rect2D[T] {
+	return New2D[T](
+		vector.New2D[T](r.Position.X-by.X, r.Position.Y-by.Y),
+		vector.New2D[T](r.Size.X+by.X*2, r.Size.Y+by.Y*2),
+	)
+}
+
+// IntersectsRay tests the ray starting at origin with direction dir against this rectangle using the
+// slab method. It returns whether the ray hits the rectangle and, when it does, the entry parameter t
+// such that the hit point is origin + dir*t.
+func (r *Rect2D[T]) IntersectsRay(origin, dir *vector.Vector2D[T]) (bool, T) {
+	ox, oy := float64(origin.X), float64(origin.Y)
+	dx, dy := float64(dir.X), float64(dir.Y)
+	minX, minY := float64(r.Position.X), float64(r.Position.Y)
+	maxX, maxY := float64(r.Position.X+r.Size.X), float64(r.Position.Y+r.Size.Y)
+
+	tmin, tmax := math.Inf(-1), math.Inf(1)
+
+	if dx != 0 {
+		t1, t2 := (minX-ox)/dx, (maxX-ox)/dx
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		tmin = math.Max(tmin, t1)
+		tmax = math.Min(tmax, t2)
+	} else if ox < minX || ox > maxX {
+		return false, 0
+	}
+
+	if dy != 0 {
+		t1, t2 := (minY-oy)/dy, (maxY-oy)/dy
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		tmin = math.Max(tmin, t1)
+		tmax = math.Min(tmax, t2)
+	} else if oy < minY || oy > maxY {
+		return false, 0
+	}
+
+	if tmax < tmin || tmax < 0 {
+		return false, 0
+	}
+	return true, T(tmin)
+}
+
 func (r *Rect2D[T]) min(a, b T) T {
 	if a < b {
 		return a
 	}
 	return b
 }
+
+func (r *Rect2D[T]) max(a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -17,3 +17,47 @@ func TestRect(t *testing.T) {
 	require.Equal(t, 2, c.X)
 	require.Equal(t, 2, c.Y)
 }
+
+func TestRectIntersectsAndIntersection(t *testing.T) {
+	a := rect.New2D[int](vector.New2D[int](0, 0), vector.New2D[int](4, 4))
+	b := rect.New2D[int](vector.New2D[int](2, 2), vector.New2D[int](4, 4))
+	c := rect.New2D[int](vector.New2D[int](10, 10), vector.New2D[int](2, 2))
+
+	require.True(t, a.Intersects(b))
+	require.False(t, a.Intersects(c))
+
+	i := a.Intersection(b)
+	require.NotNil(t, i)
+	require.Equal(t, 2, i.Position.X)
+	require.Equal(t, 2, i.Position.Y)
+	require.Equal(t, 2, i.Size.X)
+	require.Equal(t, 2, i.Size.Y)
+
+	require.Nil(t, a.Intersection(c))
+}
+
+func TestRectUnionAndEncloses(t *testing.T) {
+	a := rect.New2D[int](vector.New2D[int](0, 0), vector.New2D[int](2, 2))
+	b := rect.New2D[int](vector.New2D[int](4, 4), vector.New2D[int](2, 2))
+
+	u := a.Union(b)
+	require.Equal(t, 0, u.Position.X)
+	require.Equal(t, 0, u.Position.Y)
+	require.Equal(t, 6, u.Size.X)
+	require.Equal(t, 6, u.Size.Y)
+
+	require.True(t, u.Encloses(a))
+	require.True(t, u.Encloses(b))
+	require.False(t, a.Encloses(b))
+}
+
+func TestRectIntersectsRay(t *testing.T) {
+	r := rect.New2D[float64](vector.New2D[float64](2, 2), vector.New2D[float64](4, 4))
+
+	hit, tt := r.IntersectsRay(vector.New2D[float64](0, 4), vector.New2D[float64](1, 0))
+	require.True(t, hit)
+	require.InDelta(t, 2.0, tt, vector.EPSILON)
+
+	hit, _ = r.IntersectsRay(vector.New2D[float64](0, 0), vector.New2D[float64](0, 1))
+	require.False(t, hit)
+}
@@ -63,7 +63,7 @@ func (v *Vector2D[T]) Dot(w *Vector2D[T]) T {
 // Note: Cross product is not defined in 2D mathematically. This method embeds the 2D vectors in the XY plane of 3D space
 // and uses their cross product's Z component as the analog.
 func (v *Vector2D[T]) Cross(w *Vector2D[T]) T {
-	return v.X*w.Y + v.Y*w.X
+	return v.X*w.Y - v.Y*w.X
 }
 
 // Returns the distance between this vector and w.
@@ -134,3 +134,86 @@ func (v *Vector2D[T]) DirectionTo(to *Vector2D[T]) *Vector2D[T] {
 func (v *Vector2D[T]) Clone() *Vector2D[T] {
 	return New2D[T](v.X, v.Y)
 }
+
+// Scale returns the result of scaling this vector by s.
+func (v *Vector2D[T]) Scale(s T) *Vector2D[T] {
+	return New2D[T](v.X*s, v.Y*s)
+}
+
+// Negate returns this vector with both components' sign flipped. Equivalent to scaling by -1.
+func (v *Vector2D[T]) Negate() *Vector2D[T] {
+	return New2D[T](-v.X, -v.Y)
+}
+
+// Abs returns a vector with each component set to its absolute value.
+func (v *Vector2D[T]) Abs() *Vector2D[T] {
+	x, y := v.X, v.Y
+	if x < 0 {
+		x = -x
+	}
+	if y < 0 {
+		y = -y
+	}
+	return New2D[T](x, y)
+}
+
+// Floor returns a vector with each component rounded down to the nearest integer.
+func (v *Vector2D[T]) Floor() *Vector2D[T] {
+	return New2D[T](
+		T(math.Floor(float64(v.X))),
+		T(math.Floor(float64(v.Y))),
+	)
+}
+
+// Rotate returns this vector rotated by theta radians.
+func (v *Vector2D[T]) Rotate(theta float64) *Vector2D[T] {
+	x, y := float64(v.X), float64(v.Y)
+	sin, cos := math.Sin(theta), math.Cos(theta)
+	return New2D[T](
+		T(x*cos-y*sin),
+		T(x*sin+y*cos),
+	)
+}
+
+// Angle returns the angle of this vector in radians, measured from the positive X axis.
+// Equivalent to atan2(y, x).
+func (v *Vector2D[T]) Angle() float64 {
+	return math.Atan2(float64(v.Y), float64(v.X))
+}
+
+// AngleTo returns the signed angle in radians between this vector and w.
+func (v *Vector2D[T]) AngleTo(w *Vector2D[T]) float64 {
+	return math.Atan2(float64(v.Cross(w)), float64(v.Dot(w)))
+}
+
+// SetLength returns a vector pointing in the same direction as this one, scaled to length l.
+func (v *Vector2D[T]) SetLength(l T) *Vector2D[T] {
+	return v.Normalized().Scale(l)
+}
+
+// Reflect returns this vector reflected (bounced) off a surface with the given normal.
+func (v *Vector2D[T]) Reflect(normal *Vector2D[T]) *Vector2D[T] {
+	n := normal.Normalized()
+	return v.Subtract(n.Scale(2 * v.Dot(n)))
+}
+
+// NinetyClockwise returns this vector rotated 90 degrees clockwise, i.e. (-y, x).
+func (v *Vector2D[T]) NinetyClockwise() *Vector2D[T] {
+	return New2D[T](-v.Y, v.X)
+}
+
+// NinetyAnticlockwise returns this vector rotated 90 degrees anticlockwise, i.e. (y, -x).
+func (v *Vector2D[T]) NinetyAnticlockwise() *Vector2D[T] {
+	return New2D[T](v.Y, -v.X)
+}
+
+// ApproxEquals returns true if this vector and w are equal within eps on both axis.
+// Useful to assert on rotations/angles that do not land on exact float values.
+func (v *Vector2D[T]) ApproxEquals(w *Vector2D[T], eps float64) bool {
+	return math.Abs(float64(v.X-w.X)) <= eps && math.Abs(float64(v.Y-w.Y)) <= eps
+}
+
+// ToN converts this vector into an equivalent 2-dimensional VectorN.
+func (v *Vector2D[T]) ToN() *VectorN[T] {
+	return From2D(v)
+}
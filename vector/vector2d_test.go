@@ -1,6 +1,7 @@
 package vector_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/iuga/gomath/vector"
@@ -19,3 +20,45 @@ func TestMoveToward(t *testing.T) {
 	require.Less(t, float32(v.X), float32(9.0))
 	require.Less(t, float32(v.Y), float32(9.0))
 }
+
+func TestRotate(t *testing.T) {
+	v := vector.New2D[float64](1, 0)
+	r := v.Rotate(math.Pi / 2)
+	require.True(t, r.ApproxEquals(vector.New2D[float64](0, 1), vector.EPSILON))
+
+	r = v.Rotate(math.Pi)
+	require.True(t, r.ApproxEquals(vector.New2D[float64](-1, 0), vector.EPSILON))
+}
+
+func TestAngleAndAngleTo(t *testing.T) {
+	v := vector.New2D[float64](1, 0)
+	require.InDelta(t, 0.0, v.Angle(), vector.EPSILON)
+
+	w := vector.New2D[float64](0, 1)
+	require.InDelta(t, math.Pi/2, v.AngleTo(w), vector.EPSILON)
+}
+
+func TestAngleToNonAxisAligned(t *testing.T) {
+	v := vector.New2D[float64](0, 1)
+	w := v.Rotate(0.3)
+	require.InDelta(t, 0.3, v.AngleTo(w), vector.EPSILON)
+}
+
+func TestSetLength(t *testing.T) {
+	v := vector.New2D[float64](3, 4)
+	v = v.SetLength(10)
+	require.InDelta(t, 10.0, v.Length(), vector.EPSILON)
+}
+
+func TestReflect(t *testing.T) {
+	v := vector.New2D[float64](1, -1)
+	n := vector.New2D[float64](0, 1)
+	r := v.Reflect(n)
+	require.True(t, r.ApproxEquals(vector.New2D[float64](1, 1), vector.EPSILON))
+}
+
+func TestNinetyHelpers(t *testing.T) {
+	v := vector.New2D[int](1, 0)
+	require.True(t, v.NinetyClockwise().ApproxEquals(vector.New2D[int](0, 1), vector.EPSILON))
+	require.True(t, v.NinetyAnticlockwise().ApproxEquals(vector.New2D[int](0, -1), vector.EPSILON))
+}
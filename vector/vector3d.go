@@ -0,0 +1,157 @@
+package vector
+
+import "math"
+
+// Vector3D is a 3D vector using numeric coordinates as generics.
+// A 3-element structure that can be used to represent 3D coordinates or any other triple of numeric
+// values. It uses dedicated X/Y/Z fields rather than VectorN's slice storage, for performance and
+// ergonomics in game-like code.
+type Vector3D[T Number] struct {
+	// The vector's X component
+	X T `json:"x"`
+	// The vector's Y component
+	Y T `json:"y"`
+	// The vector's Z component
+	Z T `json:"z"`
+}
+
+// New3D constructs a new Vector3D from the given x, y and z.
+func New3D[T Number](x, y, z T) *Vector3D[T] {
+	return &Vector3D[T]{X: x, Y: y, Z: z}
+}
+
+// FromAngles constructs a unit-length-scaled Vector3D from spherical coordinates: theta is the polar
+// angle measured from the +Z axis, phi is the azimuthal angle in the XY plane, and length is the
+// resulting vector's magnitude.
+func FromAngles[T Number](theta, phi float64, length T) *Vector3D[T] {
+	l := float64(length)
+	sinTheta, cosTheta := math.Sin(theta), math.Cos(theta)
+	sinPhi, cosPhi := math.Sin(phi), math.Cos(phi)
+	return New3D[T](
+		T(l*sinTheta*cosPhi),
+		T(l*sinTheta*sinPhi),
+		T(l*cosTheta),
+	)
+}
+
+// Returns the squared length (squared magnitude) of this vector.
+func (v *Vector3D[T]) LengthSquared() T {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
+}
+
+// Returns the length (magnitude) of this vector.
+func (v *Vector3D[T]) Length() T {
+	return T(math.Sqrt(float64(v.LengthSquared())))
+}
+
+// Returns the dot product of this vector and with.
+func (v *Vector3D[T]) Dot(w *Vector3D[T]) T {
+	return v.X*w.X + v.Y*w.Y + v.Z*w.Z
+}
+
+// Cross returns the 3D cross product of this vector and w:
+// (y1*z2 - z1*y2, z1*x2 - x1*z2, x1*y2 - y1*x2).
+func (v *Vector3D[T]) Cross(w *Vector3D[T]) *Vector3D[T] {
+	return New3D[T](
+		v.Y*w.Z-v.Z*w.Y,
+		v.Z*w.X-v.X*w.Z,
+		v.X*w.Y-v.Y*w.X,
+	)
+}
+
+// Returns the distance between this vector and w.
+func (v *Vector3D[T]) DistanceTo(w *Vector3D[T]) T {
+	return T(math.Sqrt(float64(v.DistanceSquaredTo(w))))
+}
+
+// Returns the squared distance between this vector and w.
+func (v *Vector3D[T]) DistanceSquaredTo(w *Vector3D[T]) T {
+	return (v.X-w.X)*(v.X-w.X) + (v.Y-w.Y)*(v.Y-w.Y) + (v.Z-w.Z)*(v.Z-w.Z)
+}
+
+// Returns the result of the linear interpolation between this vector and to by amount weight.
+func (v *Vector3D[T]) LERP(to *Vector3D[T], weight float64) *Vector3D[T] {
+	return New3D[T](
+		v.X+(to.X-v.X)*T(weight),
+		v.Y+(to.Y-v.Y)*T(weight),
+		v.Z+(to.Z-v.Z)*T(weight),
+	)
+}
+
+// Add one vector to another
+func (v *Vector3D[T]) Add(to *Vector3D[T]) *Vector3D[T] {
+	return New3D[T](v.X+to.X, v.Y+to.Y, v.Z+to.Z)
+}
+
+// Subtract one vector from another
+func (v *Vector3D[T]) Subtract(u *Vector3D[T]) *Vector3D[T] {
+	return New3D[T](v.X-u.X, v.Y-u.Y, v.Z-u.Z)
+}
+
+// Returns the result of scaling the vector to unit length. Equivalent to v / v.length().
+// Note: This function may return incorrect values if the input vector length is near zero.
+func (v *Vector3D[T]) Normalized() *Vector3D[T] {
+	l := v.X*v.X + v.Y*v.Y + v.Z*v.Z
+	if l != 0 {
+		l = T(math.Sqrt(float64(l)))
+		return New3D[T](v.X/l, v.Y/l, v.Z/l)
+	}
+	return New3D[T](v.X, v.Y, v.Z)
+}
+
+// MoveToward returns a new vector moved toward to by the fixed delta amount. Will not go past the final value.
+func (v *Vector3D[T]) MoveToward(to *Vector3D[T], delta T) *Vector3D[T] {
+	vd := to.Subtract(v)
+	l := vd.Length()
+	if l <= delta || l < T(EPSILON) {
+		return New3D[T](to.X, to.Y, to.Z)
+	}
+	return New3D[T](v.X+vd.X/l*delta, v.Y+vd.Y/l*delta, v.Z+vd.Z/l*delta)
+}
+
+// DirectionTo returns the normalized vector pointing from this vector to to.
+func (v *Vector3D[T]) DirectionTo(to *Vector3D[T]) *Vector3D[T] {
+	return to.Subtract(v).Normalized()
+}
+
+// Clone returns a deep-copy of the current vector.
+func (v *Vector3D[T]) Clone() *Vector3D[T] {
+	return New3D[T](v.X, v.Y, v.Z)
+}
+
+// Scale returns the result of scaling this vector by s.
+func (v *Vector3D[T]) Scale(s T) *Vector3D[T] {
+	return New3D[T](v.X*s, v.Y*s, v.Z*s)
+}
+
+// Negate returns this vector with every component's sign flipped. Equivalent to scaling by -1.
+func (v *Vector3D[T]) Negate() *Vector3D[T] {
+	return New3D[T](-v.X, -v.Y, -v.Z)
+}
+
+// ApproxEquals returns true if this vector and w are equal within eps on every axis.
+func (v *Vector3D[T]) ApproxEquals(w *Vector3D[T], eps float64) bool {
+	return math.Abs(float64(v.X-w.X)) <= eps && math.Abs(float64(v.Y-w.Y)) <= eps && math.Abs(float64(v.Z-w.Z)) <= eps
+}
+
+// RotateAroundAxis returns this vector rotated by angle radians around axis, using Rodrigues' rotation
+// formula: v*cos(angle) + (k x v)*sin(angle) + k*(k.v)*(1-cos(angle)), where k is the normalized axis.
+func (v *Vector3D[T]) RotateAroundAxis(axis *Vector3D[T], angle float64) *Vector3D[T] {
+	k := axis.Normalized()
+	cos, sin := math.Cos(angle), math.Sin(angle)
+	term1 := v.Scale(T(cos))
+	term2 := k.Cross(v).Scale(T(sin))
+	term3 := k.Scale(k.Dot(v) * T(1-cos))
+	return term1.Add(term2).Add(term3)
+}
+
+// ReflectThroughPlane returns this vector reflected (bounced) off a plane with the given normal.
+func (v *Vector3D[T]) ReflectThroughPlane(normal *Vector3D[T]) *Vector3D[T] {
+	n := normal.Normalized()
+	return v.Subtract(n.Scale(2 * v.Dot(n)))
+}
+
+// ToN converts this vector into an equivalent 3-dimensional VectorN.
+func (v *Vector3D[T]) ToN() *VectorN[T] {
+	return From3D(v)
+}
@@ -0,0 +1,34 @@
+package vector_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/iuga/gomath/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVector3DCross(t *testing.T) {
+	x := vector.New3D[float64](1, 0, 0)
+	y := vector.New3D[float64](0, 1, 0)
+	require.True(t, x.Cross(y).ApproxEquals(vector.New3D[float64](0, 0, 1), vector.EPSILON))
+}
+
+func TestFromAngles(t *testing.T) {
+	v := vector.FromAngles[float64](math.Pi/2, 0, 1)
+	require.True(t, v.ApproxEquals(vector.New3D[float64](1, 0, 0), vector.EPSILON))
+}
+
+func TestRotateAroundAxis(t *testing.T) {
+	v := vector.New3D[float64](1, 0, 0)
+	axis := vector.New3D[float64](0, 0, 1)
+	r := v.RotateAroundAxis(axis, math.Pi/2)
+	require.True(t, r.ApproxEquals(vector.New3D[float64](0, 1, 0), vector.EPSILON))
+}
+
+func TestReflectThroughPlane(t *testing.T) {
+	v := vector.New3D[float64](1, -1, 0)
+	n := vector.New3D[float64](0, 1, 0)
+	r := v.ReflectThroughPlane(n)
+	require.True(t, r.ApproxEquals(vector.New3D[float64](1, 1, 0), vector.EPSILON))
+}
@@ -0,0 +1,195 @@
+package vector
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// VectorN is a vector of arbitrary, fixed dimension using numeric components as generics. Unlike
+// Vector2D/Vector3D, which use dedicated X/Y/Z fields for ergonomics, VectorN is backed by a slice so
+// it can represent any number of dimensions.
+type VectorN[T Number] struct {
+	// Values holds the vector's components, in order.
+	Values []T `json:"values"`
+}
+
+// NewN constructs a new VectorN from the given components.
+func NewN[T Number](values ...T) *VectorN[T] {
+	return &VectorN[T]{Values: values}
+}
+
+// From2D converts a Vector2D into an equivalent 2-dimensional VectorN.
+func From2D[T Number](v *Vector2D[T]) *VectorN[T] {
+	return &VectorN[T]{Values: []T{v.X, v.Y}}
+}
+
+// From3D converts a Vector3D into an equivalent 3-dimensional VectorN.
+func From3D[T Number](v *Vector3D[T]) *VectorN[T] {
+	return &VectorN[T]{Values: []T{v.X, v.Y, v.Z}}
+}
+
+// Dimension returns the number of components in this vector.
+func (v *VectorN[T]) Dimension() int {
+	return len(v.Values)
+}
+
+// Add returns the element-wise sum of this vector and w. It returns an error if the dimensions differ.
+func (v *VectorN[T]) Add(w *VectorN[T]) (*VectorN[T], error) {
+	if err := v.requireSameDimension(w); err != nil {
+		return nil, err
+	}
+	result := make([]T, len(v.Values))
+	for i := range v.Values {
+		result[i] = v.Values[i] + w.Values[i]
+	}
+	return &VectorN[T]{Values: result}, nil
+}
+
+// Subtract returns the element-wise difference of this vector and w. It returns an error if the
+// dimensions differ.
+func (v *VectorN[T]) Subtract(w *VectorN[T]) (*VectorN[T], error) {
+	if err := v.requireSameDimension(w); err != nil {
+		return nil, err
+	}
+	result := make([]T, len(v.Values))
+	for i := range v.Values {
+		result[i] = v.Values[i] - w.Values[i]
+	}
+	return &VectorN[T]{Values: result}, nil
+}
+
+// Dot returns the dot product of this vector and w. It returns an error if the dimensions differ.
+func (v *VectorN[T]) Dot(w *VectorN[T]) (T, error) {
+	if err := v.requireSameDimension(w); err != nil {
+		return 0, err
+	}
+	var sum T
+	for i := range v.Values {
+		sum += v.Values[i] * w.Values[i]
+	}
+	return sum, nil
+}
+
+// Cross returns the 3D cross product of this vector and w. Both vectors must have exactly 3 dimensions.
+func (v *VectorN[T]) Cross(w *VectorN[T]) (*VectorN[T], error) {
+	if v.Dimension() != 3 || w.Dimension() != 3 {
+		return nil, errors.New("Cross(...) is only defined for 3-dimensional vectors")
+	}
+	return &VectorN[T]{Values: []T{
+		v.Values[1]*w.Values[2] - v.Values[2]*w.Values[1],
+		v.Values[2]*w.Values[0] - v.Values[0]*w.Values[2],
+		v.Values[0]*w.Values[1] - v.Values[1]*w.Values[0],
+	}}, nil
+}
+
+// LengthSquared returns the squared length (squared magnitude) of this vector.
+func (v *VectorN[T]) LengthSquared() T {
+	var sum T
+	for _, x := range v.Values {
+		sum += x * x
+	}
+	return sum
+}
+
+// Length returns the length (magnitude) of this vector.
+func (v *VectorN[T]) Length() T {
+	return T(math.Sqrt(float64(v.LengthSquared())))
+}
+
+// Normalized returns the result of scaling the vector to unit length.
+// Note: This function may return incorrect values if the input vector length is near zero.
+func (v *VectorN[T]) Normalized() *VectorN[T] {
+	l := v.Length()
+	if l == 0 {
+		return v.Clone()
+	}
+	result := make([]T, len(v.Values))
+	for i, x := range v.Values {
+		result[i] = x / l
+	}
+	return &VectorN[T]{Values: result}
+}
+
+// LERP returns the result of the linear interpolation between this vector and to by amount weight.
+// It returns an error if the dimensions differ.
+func (v *VectorN[T]) LERP(to *VectorN[T], weight float64) (*VectorN[T], error) {
+	if err := v.requireSameDimension(to); err != nil {
+		return nil, err
+	}
+	result := make([]T, len(v.Values))
+	for i := range v.Values {
+		result[i] = v.Values[i] + (to.Values[i]-v.Values[i])*T(weight)
+	}
+	return &VectorN[T]{Values: result}, nil
+}
+
+// DistanceTo returns the distance between this vector and w. It returns an error if the dimensions differ.
+func (v *VectorN[T]) DistanceTo(w *VectorN[T]) (T, error) {
+	d, err := v.Subtract(w)
+	if err != nil {
+		return 0, err
+	}
+	return d.Length(), nil
+}
+
+// MoveToward returns a new vector moved toward to by the fixed delta amount. Will not go past the
+// final value. It returns an error if the dimensions differ.
+func (v *VectorN[T]) MoveToward(to *VectorN[T], delta T) (*VectorN[T], error) {
+	diff, err := to.Subtract(v)
+	if err != nil {
+		return nil, err
+	}
+	l := diff.Length()
+	if l <= delta || l < T(EPSILON) {
+		return to.Clone(), nil
+	}
+	result := make([]T, len(v.Values))
+	for i := range v.Values {
+		result[i] = v.Values[i] + diff.Values[i]/l*delta
+	}
+	return &VectorN[T]{Values: result}, nil
+}
+
+// DirectionTo returns the normalized vector pointing from this vector to to. It returns an error if
+// the dimensions differ.
+func (v *VectorN[T]) DirectionTo(to *VectorN[T]) (*VectorN[T], error) {
+	d, err := to.Subtract(v)
+	if err != nil {
+		return nil, err
+	}
+	return d.Normalized(), nil
+}
+
+// Clone returns a deep-copy of the current vector.
+func (v *VectorN[T]) Clone() *VectorN[T] {
+	values := make([]T, len(v.Values))
+	copy(values, v.Values)
+	return &VectorN[T]{Values: values}
+}
+
+// To2D converts this vector into a Vector2D, taking its first two components. It returns an error if
+// the vector does not have exactly 2 dimensions.
+func (v *VectorN[T]) To2D() (*Vector2D[T], error) {
+	if v.Dimension() != 2 {
+		return nil, fmt.Errorf("To2D() requires a 2-dimensional vector, got %d dimensions", v.Dimension())
+	}
+	return New2D[T](v.Values[0], v.Values[1]), nil
+}
+
+// To3D converts this vector into a Vector3D, taking its three components. It returns an error if the
+// vector does not have exactly 3 dimensions.
+func (v *VectorN[T]) To3D() (*Vector3D[T], error) {
+	if v.Dimension() != 3 {
+		return nil, fmt.Errorf("To3D() requires a 3-dimensional vector, got %d dimensions", v.Dimension())
+	}
+	return New3D[T](v.Values[0], v.Values[1], v.Values[2]), nil
+}
+
+// requireSameDimension returns an error if v and w do not share the same dimension.
+func (v *VectorN[T]) requireSameDimension(w *VectorN[T]) error {
+	if v.Dimension() != w.Dimension() {
+		return fmt.Errorf("dimension mismatch: %d vs %d", v.Dimension(), w.Dimension())
+	}
+	return nil
+}
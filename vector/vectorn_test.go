@@ -0,0 +1,49 @@
+package vector_test
+
+import (
+	"testing"
+
+	"github.com/iuga/gomath/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorNAddAndDot(t *testing.T) {
+	a := vector.NewN[float64](1, 2, 3)
+	b := vector.NewN[float64](4, 5, 6)
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	require.Equal(t, []float64{5, 7, 9}, sum.Values)
+
+	dot, err := a.Dot(b)
+	require.NoError(t, err)
+	require.Equal(t, float64(32), dot)
+
+	_, err = a.Add(vector.NewN[float64](1, 2))
+	require.Error(t, err)
+}
+
+func TestVectorNCross(t *testing.T) {
+	a := vector.NewN[float64](1, 0, 0)
+	b := vector.NewN[float64](0, 1, 0)
+
+	cross, err := a.Cross(b)
+	require.NoError(t, err)
+	require.Equal(t, []float64{0, 0, 1}, cross.Values)
+
+	_, err = vector.NewN[float64](1, 0).Cross(b)
+	require.Error(t, err)
+}
+
+func TestVectorNConversions(t *testing.T) {
+	v2 := vector.New2D[float64](1, 2)
+	n := v2.ToN()
+	require.Equal(t, []float64{1, 2}, n.Values)
+
+	back, err := n.To2D()
+	require.NoError(t, err)
+	require.Equal(t, v2, back)
+
+	v3 := vector.New3D[float64](1, 2, 3)
+	require.Equal(t, []float64{1, 2, 3}, v3.ToN().Values)
+}